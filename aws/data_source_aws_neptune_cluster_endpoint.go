@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/neptune/finder"
+)
+
+func dataSourceAwsNeptuneClusterEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsNeptuneClusterEndpointRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_endpoint_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"static_members": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"excluded_members": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsNeptuneClusterEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).neptuneconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	clusterId := d.Get("cluster_identifier").(string)
+	endpointId := d.Get("cluster_endpoint_identifier").(string)
+	id := fmt.Sprintf("%s:%s", clusterId, endpointId)
+
+	resp, err := finder.EndpointById(conn, id)
+	if err != nil {
+		return fmt.Errorf("error describing Neptune Cluster Endpoint (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("cluster_endpoint_identifier", resp.DBClusterEndpointIdentifier)
+	d.Set("cluster_identifier", resp.DBClusterIdentifier)
+	d.Set("endpoint_type", resp.CustomEndpointType)
+	d.Set("endpoint", resp.Endpoint)
+	d.Set("excluded_members", flattenStringSet(resp.ExcludedMembers))
+	d.Set("static_members", flattenStringSet(resp.StaticMembers))
+
+	arn := aws.StringValue(resp.DBClusterEndpointArn)
+	d.Set("arn", arn)
+
+	if neptuneClusterEndpointTagsSupportedInPartition(meta.(*AWSClient).partition) {
+		tags, err := keyvaluetags.NeptuneListTags(conn, arn)
+
+		if err != nil {
+			return fmt.Errorf("error listing tags for Neptune Cluster Endpoint (%s): %w", arn, err)
+		}
+
+		if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+			return fmt.Errorf("error setting tags: %w", err)
+		}
+	} else {
+		d.Set("tags", nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSNeptuneClusterEndpointDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_neptune_cluster_endpoint.test"
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneClusterEndpointDataSourceConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_identifier", resourceName, "cluster_identifier"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_endpoint_identifier", resourceName, "cluster_endpoint_identifier"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint", resourceName, "endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint_type", resourceName, "endpoint_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSNeptuneClusterEndpointDataSourceConfigBasic(rName string) string {
+	return testAccAWSNeptuneClusterEndpointConfigBase(rName) + fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "READER"
+}
+
+data "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster_endpoint.test.cluster_identifier
+  cluster_endpoint_identifier = aws_neptune_cluster_endpoint.test.cluster_endpoint_identifier
+}
+`, rName)
+}
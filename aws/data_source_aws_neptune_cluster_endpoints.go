@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/neptune/finder"
+)
+
+func dataSourceAwsNeptuneClusterEndpoints() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsNeptuneClusterEndpointsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"READER", "WRITER", "ANY", "CUSTOM"}, false),
+			},
+			"endpoint_identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsNeptuneClusterEndpointsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).neptuneconn
+
+	clusterId := d.Get("cluster_identifier").(string)
+
+	endpoints, err := finder.EndpointsByClusterIdAndType(conn, clusterId, d.Get("endpoint_type").(string))
+	if err != nil {
+		return fmt.Errorf("error describing Neptune Cluster Endpoints (%s): %w", clusterId, err)
+	}
+
+	var endpointIdentifiers, endpointAddresses, arns []string
+	for _, endpoint := range endpoints {
+		endpointIdentifiers = append(endpointIdentifiers, aws.StringValue(endpoint.DBClusterEndpointIdentifier))
+		endpointAddresses = append(endpointAddresses, aws.StringValue(endpoint.Endpoint))
+		arns = append(arns, aws.StringValue(endpoint.DBClusterEndpointArn))
+	}
+
+	d.SetId(clusterId)
+	d.Set("endpoint_identifiers", endpointIdentifiers)
+	d.Set("endpoints", endpointAddresses)
+	d.Set("arns", arns)
+
+	return nil
+}
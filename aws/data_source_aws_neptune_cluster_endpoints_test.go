@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSNeptuneClusterEndpointsDataSource_endpointType(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_neptune_cluster_endpoints.test"
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneClusterEndpointsDataSourceConfigEndpointType(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "endpoint_identifiers.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "endpoints.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint_identifiers.0", resourceName, "cluster_endpoint_identifier"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSNeptuneClusterEndpointsDataSourceConfigEndpointType(rName string) string {
+	return testAccAWSNeptuneClusterEndpointConfigBase(rName) + fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "READER"
+}
+
+data "aws_neptune_cluster_endpoints" "test" {
+  cluster_identifier = aws_neptune_cluster_endpoint.test.cluster_identifier
+  endpoint_type       = "READER"
+}
+`, rName)
+}
@@ -0,0 +1,83 @@
+package finder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// EndpointById returns the Neptune cluster endpoint corresponding to the specified ID.
+// Returns a resource.NotFoundError if no cluster endpoint is found.
+func EndpointById(conn *neptune.Neptune, id string) (*neptune.DBClusterEndpoint, error) {
+	clusterId, endpointId, err := decodeNeptuneClusterEndpointID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &neptune.DescribeDBClusterEndpointsInput{
+		DBClusterIdentifier:         aws.String(clusterId),
+		DBClusterEndpointIdentifier: aws.String(endpointId),
+	}
+
+	output, err := conn.DescribeDBClusterEndpoints(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBClusterEndpoints) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if len(output.DBClusterEndpoints) > 1 {
+		return nil, tfresource.NewTooManyResultsError(len(output.DBClusterEndpoints), input)
+	}
+
+	return output.DBClusterEndpoints[0], nil
+}
+
+// EndpointsByClusterIdAndType returns all of the custom cluster endpoints for the given Neptune
+// cluster, optionally filtered to a single endpoint type (READER, WRITER, ANY, or CUSTOM).
+func EndpointsByClusterIdAndType(conn *neptune.Neptune, clusterId, endpointType string) ([]*neptune.DBClusterEndpoint, error) {
+	input := &neptune.DescribeDBClusterEndpointsInput{
+		DBClusterIdentifier: aws.String(clusterId),
+	}
+
+	if endpointType != "" {
+		input.Filters = []*neptune.Filter{
+			{
+				Name:   aws.String("db-cluster-endpoint-type"),
+				Values: aws.StringSlice([]string{endpointType}),
+			},
+		}
+	}
+
+	var results []*neptune.DBClusterEndpoint
+
+	err := conn.DescribeDBClusterEndpointsPages(input, func(page *neptune.DescribeDBClusterEndpointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		results = append(results, page.DBClusterEndpoints...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func decodeNeptuneClusterEndpointID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected cluster-id:endpoint-id", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,50 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// DefaultDBClusterEndpointTimeout is the default Create, Update and Delete timeout of the
+	// Neptune cluster endpoint resource, used when no timeout is configured for the operation.
+	DefaultDBClusterEndpointTimeout = 10 * time.Minute
+)
+
+// DBClusterEndpointAvailable waits for a DB Cluster Endpoint to reach the available state.
+func DBClusterEndpointAvailable(conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.DBClusterEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating", "modifying"},
+		Target:  []string{"available"},
+		Refresh: statusDBClusterEndpoint(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*neptune.DBClusterEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DBClusterEndpointDeleted waits for a DB Cluster Endpoint to be deleted.
+func DBClusterEndpointDeleted(conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.DBClusterEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting"},
+		Target:  []string{},
+		Refresh: statusDBClusterEndpoint(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*neptune.DBClusterEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
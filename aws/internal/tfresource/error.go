@@ -0,0 +1,31 @@
+package tfresource
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// NotFound returns true if the error represents a "resource not found" condition.
+// Specifically, NotFound returns true if the error or its underlying cause is of type
+// resource.NotFoundError.
+func NotFound(err error) bool {
+	var e *resource.NotFoundError
+	return errors.As(err, &e)
+}
+
+// NewEmptyResultError returns an error for when a find operation returns no results.
+func NewEmptyResultError(lastRequest interface{}) error {
+	return &resource.NotFoundError{
+		Message:     "empty result",
+		LastRequest: lastRequest,
+	}
+}
+
+// NewTooManyResultsError returns an error for when a find operation returns more results than
+// expected. Deliberately does not embed a *resource.NotFoundError so that NotFound(err) does not
+// match it: callers need to distinguish "found too many" from "found none".
+func NewTooManyResultsError(count int, lastRequest interface{}) error {
+	return fmt.Errorf("too many results: wanted 1, got %d, request: %v", count, lastRequest)
+}
@@ -0,0 +1,19 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider returns a *schema.Provider.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_neptune_cluster_endpoint":  dataSourceAwsNeptuneClusterEndpoint(),
+			"aws_neptune_cluster_endpoints": dataSourceAwsNeptuneClusterEndpoints(),
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_neptune_cluster_endpoint": resourceAwsNeptuneClusterEndpoint(),
+		},
+	}
+}
@@ -16,6 +16,20 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
+// neptuneClusterEndpointTagsSupportedPartitions lists the partitions in which the Neptune
+// cluster endpoint tagging APIs are available. AWS has expanded support for these APIs beyond
+// the standard commercial partition over time, so this is checked instead of hard-coding a
+// single partition.
+var neptuneClusterEndpointTagsSupportedPartitions = map[string]bool{
+	endpoints.AwsPartitionID:      true,
+	endpoints.AwsUsGovPartitionID: true,
+	endpoints.AwsCnPartitionID:    true,
+}
+
+func neptuneClusterEndpointTagsSupportedInPartition(partition string) bool {
+	return neptuneClusterEndpointTagsSupportedPartitions[partition]
+}
+
 func resourceAwsNeptuneClusterEndpoint() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsNeptuneClusterEndpointCreate,
@@ -26,6 +40,12 @@ func resourceAwsNeptuneClusterEndpoint() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.DefaultDBClusterEndpointTimeout),
+			Update: schema.DefaultTimeout(waiter.DefaultDBClusterEndpointTimeout),
+			Delete: schema.DefaultTimeout(waiter.DefaultDBClusterEndpointTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -90,8 +110,7 @@ func resourceAwsNeptuneClusterEndpointCreate(d *schema.ResourceData, meta interf
 		input.ExcludedMembers = expandStringSet(attr)
 	}
 
-	// Tags are currently only supported in AWS Commercial.
-	if len(tags) > 0 && meta.(*AWSClient).partition == endpoints.AwsPartitionID {
+	if len(tags) > 0 && neptuneClusterEndpointTagsSupportedInPartition(meta.(*AWSClient).partition) {
 		input.Tags = tags.IgnoreAws().NeptuneTags()
 	}
 
@@ -104,7 +123,7 @@ func resourceAwsNeptuneClusterEndpointCreate(d *schema.ResourceData, meta interf
 	endpointId := aws.StringValue(out.DBClusterEndpointIdentifier)
 	d.SetId(fmt.Sprintf("%s:%s", clusterId, endpointId))
 
-	_, err = waiter.DBClusterEndpointAvailable(conn, d.Id())
+	_, err = waiter.DBClusterEndpointAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("error waiting for Neptune Cluster Endpoint (%q) to be Available: %w", d.Id(), err)
 	}
@@ -139,8 +158,7 @@ func resourceAwsNeptuneClusterEndpointRead(d *schema.ResourceData, meta interfac
 	arn := aws.StringValue(resp.DBClusterEndpointArn)
 	d.Set("arn", arn)
 
-	// Tags are currently only supported in AWS Commercial.
-	if meta.(*AWSClient).partition == endpoints.AwsPartitionID {
+	if neptuneClusterEndpointTagsSupportedInPartition(meta.(*AWSClient).partition) {
 		tags, err := keyvaluetags.NeptuneListTags(conn, arn)
 
 		if err != nil {
@@ -190,14 +208,13 @@ func resourceAwsNeptuneClusterEndpointUpdate(d *schema.ResourceData, meta interf
 			return fmt.Errorf("error updating Neptune Cluster Endpoint (%q): %w", d.Id(), err)
 		}
 
-		_, err = waiter.DBClusterEndpointAvailable(conn, d.Id())
+		_, err = waiter.DBClusterEndpointAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("error waiting for Neptune Cluster Endpoint (%q) to be Available: %w", d.Id(), err)
 		}
 	}
 
-	// Tags are currently only supported in AWS Commercial.
-	if d.HasChange("tags_all") && meta.(*AWSClient).partition == endpoints.AwsPartitionID {
+	if d.HasChange("tags_all") && neptuneClusterEndpointTagsSupportedInPartition(meta.(*AWSClient).partition) {
 		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.NeptuneUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
@@ -224,7 +241,7 @@ func resourceAwsNeptuneClusterEndpointDelete(d *schema.ResourceData, meta interf
 		}
 		return fmt.Errorf("Neptune Cluster Endpoint cannot be deleted: %w", err)
 	}
-	_, err = waiter.DBClusterEndpointDeleted(conn, d.Id())
+	_, err = waiter.DBClusterEndpointDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		if tfawserr.ErrCodeEquals(err, neptune.ErrCodeDBClusterEndpointNotFoundFault) {
 			return nil
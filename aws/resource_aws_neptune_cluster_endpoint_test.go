@@ -0,0 +1,155 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/neptune/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSNeptuneClusterEndpoint_timeouts(t *testing.T) {
+	var dbClusterEndpoint neptune.DBClusterEndpoint
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneClusterEndpointConfigTimeouts(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneClusterEndpointExists(resourceName, &dbClusterEndpoint),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAWSNeptuneClusterEndpoint_tagsPartition exercises tag support in partitions beyond
+// AWS Commercial. It is skipped by default and only runs when TF_ACC_PARTITION is set to any
+// non-empty value (e.g. "aws-us-gov" or "aws-cn"); the value itself is not checked.
+func TestAccAWSNeptuneClusterEndpoint_tagsPartition(t *testing.T) {
+	var dbClusterEndpoint neptune.DBClusterEndpoint
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_neptune_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSNeptuneClusterEndpointTagsPartition(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNeptuneClusterEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNeptuneClusterEndpointConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNeptuneClusterEndpointExists(resourceName, &dbClusterEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSNeptuneClusterEndpointTagsPartition(t *testing.T) {
+	if os.Getenv("TF_ACC_PARTITION") == "" {
+		t.Skip("TF_ACC_PARTITION must be set (e.g. aws-us-gov, aws-cn) to run this test against a non-commercial partition")
+	}
+}
+
+func testAccCheckAWSNeptuneClusterEndpointExists(resourceName string, v *neptune.DBClusterEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no Neptune Cluster Endpoint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+
+		resp, err := finder.EndpointById(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckAWSNeptuneClusterEndpointDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_neptune_cluster_endpoint" {
+			continue
+		}
+
+		_, err := finder.EndpointById(conn, rs.Primary.ID)
+		if tfresource.NotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Neptune Cluster Endpoint (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSNeptuneClusterEndpointConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier  = %[1]q
+  engine              = "neptune"
+  skip_final_snapshot = true
+  apply_immediately   = true
+}
+`, rName)
+}
+
+func testAccAWSNeptuneClusterEndpointConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSNeptuneClusterEndpointConfigBase(rName) + fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "READER"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSNeptuneClusterEndpointConfigTimeouts(rName string) string {
+	return testAccAWSNeptuneClusterEndpointConfigBase(rName) + fmt.Sprintf(`
+resource "aws_neptune_cluster_endpoint" "test" {
+  cluster_identifier          = aws_neptune_cluster.test.cluster_identifier
+  cluster_endpoint_identifier = %[1]q
+  endpoint_type               = "READER"
+
+  timeouts {
+    create = "30m"
+    update = "30m"
+    delete = "30m"
+  }
+}
+`, rName)
+}